@@ -20,8 +20,11 @@ import (
     "encoding/base64"
     "errors"
     "fmt"
-    "path/filepath"
     "io"
+    "io/ioutil"
+    "path/filepath"
+    "strings"
+    "time"
 
     "../../go-whisk/whisk"
     "../wski18n"
@@ -38,6 +41,9 @@ const ACTIVATION_ID = "activationId"
 const WEB_EXPORT_ANNOT = "web-export"
 const RAW_HTTP_ANNOT = "raw-http"
 const FINAL_ANNOT = "final"
+const DOCKER_FLAG = "docker"
+const DOCKER_IMAGE_DEFAULT = "openwhisk/dockerskeleton"
+const MAX_RETRY_BACKOFF = 5 * time.Second
 
 var actionCmd = &cobra.Command{
     Use:   "action",
@@ -45,7 +51,7 @@ var actionCmd = &cobra.Command{
 }
 
 var actionCreateCmd = &cobra.Command{
-    Use:           "create ACTION_NAME ACTION",
+    Use:           "create ACTION_NAME [ACTION]",
     Short:         wski18n.T("create a new action"),
     SilenceUsage:  true,
     SilenceErrors: true,
@@ -56,10 +62,10 @@ var actionCreateCmd = &cobra.Command{
 
         if whiskErr := checkArgs(
             args,
-            2,
+            1,
             2,
             "Action create",
-            wski18n.T("An action name and action are required.")); whiskErr != nil {
+            wski18n.T("An action name is required. An action is optional when --docker is used without a zip or source file.")); whiskErr != nil {
                 return whiskErr
         }
 
@@ -71,9 +77,7 @@ var actionCreateCmd = &cobra.Command{
             return actionInsertError(action, err)
         }
 
-        printActionCreated(action.Name)
-
-        return nil
+        return printActionResult(action, func() { printActionCreated(action.Name) })
     },
 }
 
@@ -104,9 +108,7 @@ var actionUpdateCmd = &cobra.Command{
             return actionInsertError(action, err)
         }
 
-        printActionUpdated(action.Name)
-
-        return nil
+        return printActionResult(action, func() { printActionUpdated(action.Name) })
     },
 }
 
@@ -144,6 +146,14 @@ var actionInvokeCmd = &cobra.Command{
             }
         }
 
+        if flags.action.tail && !flags.common.blocking {
+            return nonNestedError(wski18n.T("The --tail flag requires --blocking"))
+        }
+
+        if flags.common.blocking && flags.action.tail {
+            return invokeAndTailLogs(qualifiedName, parameters)
+        }
+
         res, _, err := client.Actions.Invoke(
             qualifiedName.entityName,
             parameters,
@@ -154,6 +164,80 @@ var actionInvokeCmd = &cobra.Command{
     },
 }
 
+func invokeAndTailLogs(qualifiedName QualifiedName, parameters interface{}) error {
+    res, _, err := client.Actions.Invoke(qualifiedName.entityName, parameters, false, flags.action.result)
+
+    if err != nil {
+        return handleInvocationError(err, qualifiedName.entityName, parameters)
+    }
+
+    activationID := fmt.Sprintf("%v", getValueFromJSONResponse(ACTIVATION_ID, res))
+
+    if !flags.action.result {
+        fmt.Fprintf(
+            color.Output,
+            wski18n.T(
+                "{{.ok}} invoked /{{.namespace}}/{{.name}} with id {{.id}}, tailing logs\n",
+                map[string]interface{}{
+                    "ok": color.GreenString("ok:"),
+                    "namespace": boldString(qualifiedName.namespace),
+                    "name": boldString(qualifiedName.entityName),
+                    "id": boldString(activationID),
+                }))
+    }
+
+    seenLogLines := map[string]bool{}
+    ticker := time.NewTicker(flags.action.tailInterval)
+    defer ticker.Stop()
+
+    for {
+        if activation, _, getErr := client.Activations.Get(activationID); getErr == nil {
+            printNewLogLines(activation.Logs, seenLogLines)
+            response := filterActivationResult(activation.Response)
+
+            if len(flags.common.format) > 0 {
+                return printFormatted(flags.common.format, response, color.Output)
+            }
+
+            printJSON(response, color.Output)
+
+            return nil
+        }
+
+        if logs, _, logsErr := client.Activations.Logs(activationID); logsErr == nil {
+            printNewLogLines(logs.Logs, seenLogLines)
+        }
+
+        <-ticker.C
+    }
+}
+
+// De-duplicates on the full line, since logs and activation endpoints both hand back
+// the same "timestamp stream: message" lines verbatim on every poll.
+func printNewLogLines(lines []string, seenLogLines map[string]bool) {
+    for _, line := range lines {
+        if seenLogLines[line] {
+            continue
+        }
+
+        seenLogLines[line] = true
+        fmt.Fprintln(color.Output, line)
+    }
+}
+
+// Mirrors the server-side result-only filtering client.Actions.Invoke does for -r/--result.
+func filterActivationResult(response map[string]interface{}) map[string]interface{} {
+    if !flags.action.result {
+        return response
+    }
+
+    if result, ok := response["result"].(map[string]interface{}); ok {
+        return result
+    }
+
+    return response
+}
+
 func handleInvocationResponse(
     qualifiedName QualifiedName,
     parameters interface{},
@@ -171,10 +255,9 @@ func handleInvocationResponse(
                 return handleInvocationError(err, qualifiedName.entityName, parameters)
             } else {
                 if isBlockingTimeout(err) {
-                    printBlockingTimeoutMsg(
-                        qualifiedName.namespace,
-                        qualifiedName.entityName,
-                        getValueFromJSONResponse(ACTIVATION_ID, result))
+                    return waitForBlockingResult(
+                        qualifiedName,
+                        fmt.Sprintf("%v", getValueFromJSONResponse(ACTIVATION_ID, result)))
                 } else if isApplicationError(err) {
                     printInvocationMsg(
                         qualifiedName.namespace,
@@ -191,6 +274,49 @@ func handleInvocationResponse(
         return err
 }
 
+// The poll interval starts at --wait-interval and doubles on every miss, up to MAX_RETRY_BACKOFF.
+func waitForBlockingResult(qualifiedName QualifiedName, activationID string) error {
+    interval := flags.action.waitInterval
+    deadline := time.Now().Add(flags.action.wait)
+    attempts := 0
+
+    for {
+        if activation, _, err := client.Activations.Get(activationID); err == nil {
+            printInvocationMsg(
+                qualifiedName.namespace,
+                qualifiedName.entityName,
+                activationID,
+                filterActivationResult(activation.Response),
+                color.Output)
+
+            return nil
+        }
+
+        attempts++
+
+        if (flags.action.retryLimit > 0 && attempts >= flags.action.retryLimit) || !time.Now().Before(deadline) {
+            printBlockingTimeoutMsg(qualifiedName.namespace, qualifiedName.entityName, activationID)
+            return activationTimeoutError(activationID)
+        }
+
+        time.Sleep(interval)
+
+        if interval *= 2; interval > MAX_RETRY_BACKOFF {
+            interval = MAX_RETRY_BACKOFF
+        }
+    }
+}
+
+func activationTimeoutError(activationID string) (error) {
+    errMsg := wski18n.T(
+        "Activation '{{.id}}' has still not completed; check its status later with 'wsk activation get {{.id}}'",
+        map[string]interface{}{
+            "id": activationID,
+        })
+
+    return nonNestedError(errMsg)
+}
+
 var actionGetCmd = &cobra.Command{
     Use:           "get ACTION_NAME [FIELD_FILTER]",
     Short:         wski18n.T("get action"),
@@ -220,11 +346,20 @@ var actionGetCmd = &cobra.Command{
         }
 
         client.Namespace = qualifiedName.namespace
+        needsCode := flags.action.save || len(flags.action.saveAs) > 0
 
-        if action, _, err = client.Actions.Get(qualifiedName.entityName); err != nil {
+        if action, _, err = client.Actions.Get(qualifiedName.entityName, &whisk.ActionGetOptions{Code: needsCode}); err != nil {
             return actionGetError(qualifiedName.entityName, err)
         }
 
+        if needsCode {
+            return saveActionCode(action, flags.action.saveAs)
+        }
+
+        if len(flags.common.format) > 0 {
+            return printFormatted(flags.common.format, action, color.Output)
+        }
+
         if flags.common.summary {
             printSummary(action)
         } else {
@@ -239,6 +374,223 @@ var actionGetCmd = &cobra.Command{
     },
 }
 
+func saveActionCode(action *whisk.Action, destination string) error {
+    if action.Exec == nil {
+        return actionSaveNoCodeError(action.Name)
+    }
+
+    filename := destination
+
+    if len(filename) == 0 {
+        ext, err := codeExtensionForKind(action.Exec.Kind)
+
+        if err != nil {
+            return err
+        }
+
+        filename = action.Name + ext
+    }
+
+    var contents []byte
+    var err error
+
+    switch {
+    case action.Exec.Kind == "java":
+        if len(action.Exec.Jar) == 0 {
+            return actionSaveNoCodeError(action.Name)
+        }
+
+        if contents, err = base64.StdEncoding.DecodeString(action.Exec.Jar); err != nil {
+            return actionSaveDecodeError(action.Name, err)
+        }
+    case isBinaryKind(action.Exec.Kind):
+        if action.Exec.Code == nil {
+            return actionSaveNoCodeError(action.Name)
+        }
+
+        if contents, err = base64.StdEncoding.DecodeString(*action.Exec.Code); err != nil {
+            return actionSaveDecodeError(action.Name, err)
+        }
+    default:
+        if action.Exec.Code == nil {
+            return actionSaveNoCodeError(action.Name)
+        }
+
+        contents = []byte(*action.Exec.Code)
+    }
+
+    if err = ioutil.WriteFile(filename, contents, 0644); err != nil {
+        return actionSaveWriteError(filename, err)
+    }
+
+    printActionCodeSaved(action.Name, filename)
+
+    return nil
+}
+
+// Java is handled separately, since its code lives in Exec.Jar, not Exec.Code.
+func isBinaryKind(kind string) bool {
+    return kind == "blackbox" || strings.HasSuffix(kind, "-binary")
+}
+
+func codeExtensionForKind(kind string) (string, error) {
+    switch {
+    case kind == "java":
+        return ".jar", nil
+    case isBinaryKind(kind):
+        return ".zip", nil
+    case strings.HasPrefix(kind, "nodejs"):
+        return ".js", nil
+    case strings.HasPrefix(kind, "python"):
+        return ".py", nil
+    case strings.HasPrefix(kind, "swift"):
+        return ".swift", nil
+    case kind == "sequence":
+        return "", actionSaveSequenceError()
+    default:
+        return "", actionSaveUnknownKindError(kind)
+    }
+}
+
+var actionCloneCmd = &cobra.Command{
+    Use:           "clone ACTION_NAME NEW_ACTION_NAME",
+    Short:         wski18n.T("clone an existing action under a new name"),
+    SilenceUsage:  true,
+    SilenceErrors: true,
+    PreRunE:       setupClientConfig,
+    RunE: func(cmd *cobra.Command, args []string) error {
+        var action *whisk.Action
+        var err error
+
+        if whiskErr := checkArgs(
+            args,
+            2,
+            2,
+            "Action clone",
+            wski18n.T("An existing action name and a new action name are required.")); whiskErr != nil {
+                return whiskErr
+        }
+
+        if action, err = cloneAction(cmd, args); err != nil {
+            return actionParseError(cmd, args, err)
+        }
+
+        if _, _, err = client.Actions.Insert(action, false); err != nil {
+            return actionInsertError(action, err)
+        }
+
+        return printActionResult(action, func() { printActionCreated(action.Name) })
+    },
+}
+
+func cloneAction(cmd *cobra.Command, args []string) (*whisk.Action, error) {
+    var err error
+    var existingAction *whisk.Action
+
+    sourceQualifiedName, err := parseQualifiedName(args[0])
+    if err != nil {
+        return nil, parseQualifiedNameError(args[0], err)
+    }
+
+    client.Namespace = sourceQualifiedName.namespace
+
+    if existingAction, _, err = client.Actions.Get(sourceQualifiedName.entityName, &whisk.ActionGetOptions{Code: true}); err != nil {
+        return nil, actionCopyGetError(sourceQualifiedName.entityName, err)
+    }
+
+    targetQualifiedName, err := parseQualifiedName(args[1])
+    if err != nil {
+        return nil, parseQualifiedNameError(args[1], err)
+    }
+
+    if len(flags.action.targetNamespace) > 0 {
+        targetQualifiedName.namespace = flags.action.targetNamespace
+    }
+
+    client.Namespace = targetQualifiedName.namespace
+
+    action := new(whisk.Action)
+    action.Name = targetQualifiedName.entityName
+    action.Namespace = targetQualifiedName.namespace
+    action.Exec = existingAction.Exec
+    action.Parameters = existingAction.Parameters
+    action.Annotations = existingAction.Annotations
+    action.Limits = existingAction.Limits
+
+    if len(flags.action.kind) > 0 {
+        action.Exec.Kind = flags.action.kind
+    }
+
+    if len(flags.action.main) > 0 {
+        action.Exec.Main = flags.action.main
+    }
+
+    if overlayLimits := getLimits(
+        cmd.LocalFlags().Changed(MEMORY_FLAG),
+        cmd.LocalFlags().Changed(LOG_SIZE_FLAG),
+        cmd.LocalFlags().Changed(TIMEOUT_FLAG),
+        flags.action.memory,
+        flags.action.logsize,
+        flags.action.timeout); overlayLimits != nil {
+            if action.Limits == nil {
+                action.Limits = new(whisk.Limits)
+            }
+
+            if overlayLimits.Memory != nil {
+                action.Limits.Memory = overlayLimits.Memory
+            }
+
+            if overlayLimits.Logsize != nil {
+                action.Limits.Logsize = overlayLimits.Logsize
+            }
+
+            if overlayLimits.Timeout != nil {
+                action.Limits.Timeout = overlayLimits.Timeout
+            }
+    }
+
+    if len(flags.common.param) > 0 {
+        var parameters interface{}
+
+        if parameters, err = getJSONFromStrings(flags.common.param, true); err != nil {
+            return nil, getJSONFromStringsParamError(flags.common.param, true, err)
+        }
+
+        action.Parameters = parameters.(whisk.KeyValueArr)
+    }
+
+    if len(flags.common.annotation) > 0 {
+        var annotations interface{}
+
+        if annotations, err = getJSONFromStrings(flags.common.annotation, true); err != nil {
+            return nil, getJSONFromStringsAnnotError(flags.common.annotation, true, err)
+        }
+
+        action.Annotations = annotations.(whisk.KeyValueArr)
+    }
+
+    if cmd.LocalFlags().Changed("shared") {
+        shared := flags.action.shared == "yes"
+        action.Publish = &shared
+    }
+
+    if flags.action.raw {
+        action.Annotations, err = webActionAnnotations(false, action.Annotations, targetQualifiedName, addRawAnnotations)
+    } else if flags.action.web {
+        action.Annotations, err = webActionAnnotations(false, action.Annotations, targetQualifiedName, addWebAnnotations)
+    } else if flags.action.noRaw {
+        action.Annotations, err = webActionAnnotations(false, action.Annotations, targetQualifiedName, deleteRawAnnotations)
+    } else if flags.action.noWeb {
+        action.Annotations, err = webActionAnnotations(false, action.Annotations, targetQualifiedName, deleteWebAnnotations)
+    }
+
+    if err != nil {
+        return nil, err
+    }
+
+    return action, nil
+}
+
 var actionDeleteCmd = &cobra.Command{
     Use:           "delete ACTION_NAME",
     Short:         wski18n.T("delete action"),
@@ -247,6 +599,7 @@ var actionDeleteCmd = &cobra.Command{
     PreRunE:       setupClientConfig,
     RunE: func(cmd *cobra.Command, args []string) error {
         var qualifiedName QualifiedName
+        var deletedAction *whisk.Action
         var err error
 
         if whiskErr := checkArgs(
@@ -264,18 +617,16 @@ var actionDeleteCmd = &cobra.Command{
 
         client.Namespace = qualifiedName.namespace
 
-        if _, err = client.Actions.Delete(qualifiedName.entityName); err != nil {
+        if deletedAction, _, err = client.Actions.Delete(qualifiedName.entityName); err != nil {
             return actionDeleteError(qualifiedName.entityName, err)
         }
 
-        printActionDeleted(qualifiedName.entityName)
-
-        return nil
+        return printActionResult(deletedAction, func() { printActionDeleted(qualifiedName.entityName) })
     },
 }
 
 var actionListCmd = &cobra.Command{
-    Use:           "list [NAMESPACE]",
+    Use:           "list [NAMESPACE[/PACKAGE]]",
     Short:         wski18n.T("list all actions"),
     SilenceUsage:  true,
     SilenceErrors: true,
@@ -290,6 +641,10 @@ var actionListCmd = &cobra.Command{
                 return parseQualifiedNameError(args[0], err)
             }
 
+            if err = validateNamespaceListArg(args[0], qualifiedName); err != nil {
+                return err
+            }
+
             client.Namespace = qualifiedName.namespace
         } else if whiskErr := checkArgs(
             args,
@@ -309,6 +664,10 @@ var actionListCmd = &cobra.Command{
             return actionListError(qualifiedName.entityName, options, err)
         }
 
+        if len(flags.common.format) > 0 {
+            return printFormatted(flags.common.format, actions, color.Output)
+        }
+
         printList(actions)
 
         return nil
@@ -377,8 +736,10 @@ func parseAction(cmd *cobra.Command, args []string, update bool) (*whisk.Action,
 
         client.Namespace = copiedQualifiedName.namespace
 
-        if existingAction, _, err = client.Actions.Get(copiedQualifiedName.entityName); err != nil {
-            return nil, actionGetError(copiedQualifiedName.entityName, err)
+        // The copy always needs the underlying source, so request the code payload explicitly
+        // rather than relying on the Get default.
+        if existingAction, _, err = client.Actions.Get(copiedQualifiedName.entityName, &whisk.ActionGetOptions{Code: true}); err != nil {
+            return nil, actionCopyGetError(copiedQualifiedName.entityName, err)
         }
 
         client.Namespace = qualifiedName.namespace
@@ -389,11 +750,20 @@ func parseAction(cmd *cobra.Command, args []string, update bool) (*whisk.Action,
         action.Exec = new(whisk.Exec)
         action.Exec.Kind = "sequence"
         action.Exec.Components = csvToQualifiedActions(artifact)
+    } else if cmd.LocalFlags().Changed(DOCKER_FLAG) && artifact == "" {
+        // A pure docker image reference, with no code of any kind.
+        action.Exec = new(whisk.Exec)
+        action.Exec.Kind = "blackbox"
+        action.Exec.Image = dockerImage(flags.action.docker)
     } else if artifact != "" {
         ext := filepath.Ext(artifact)
+        dockerChanged := cmd.LocalFlags().Changed(DOCKER_FLAG)
         action.Exec = new(whisk.Exec)
 
-        if !flags.action.docker || ext == ".zip" {
+        // --docker only implies a codeless blackbox action when no other kind was requested;
+        // an explicit --kind (or a .zip bundle, which always carries its own source) still
+        // needs its code read even if --docker was also passed.
+        if !dockerChanged || ext == ".zip" || len(flags.action.kind) > 0 {
             code, err = readFile(artifact)
             action.Exec.Code = &code
 
@@ -416,13 +786,13 @@ func parseAction(cmd *cobra.Command, args []string, update bool) (*whisk.Action,
             action.Exec.Kind = "nodejs"
         } else if flags.action.kind == "python" {
             action.Exec.Kind = "python"
-        } else if flags.action.docker {
+        } else if dockerChanged {
+            // A docker image with no --kind: a .zip payload is still read and base64-encoded
+            // below, while any other artifact is not read, so the action carries no code. The
+            // image is whatever the user supplied (or the default skeleton if they just passed
+            // --docker with no value).
             action.Exec.Kind = "blackbox"
-            if ext != ".zip" {
-                action.Exec.Image = artifact
-            } else {
-                action.Exec.Image = "openwhisk/dockerskeleton"
-            }
+            action.Exec.Image = dockerImage(flags.action.docker)
         } else if len(flags.action.kind) > 0 {
             whisk.Debug(whisk.DbgError, "--kind argument '%s' is not supported\n", flags.action.kind)
             errMsg := wski18n.T("'{{.name}}' is not a supported action runtime",
@@ -541,6 +911,14 @@ func deleteRawAnnotations(annotations whisk.KeyValueArr) (whisk.KeyValueArr) {
     return annotations
 }
 
+func dockerImage(image string) string {
+    if len(image) == 0 {
+        return DOCKER_IMAGE_DEFAULT
+    }
+
+    return image
+}
+
 func getLimits(memorySet bool, logSizeSet bool, timeoutSet bool, memory int, logSize int, timeout int) (*whisk.Limits) {
     var limits *whisk.Limits
 
@@ -668,6 +1046,19 @@ func actionDeleteError(entityName string, err error) (error) {
 func actionGetError(entityName string, err error) (error) {
     whisk.Debug(whisk.DbgError, "client.Actions.Get(%s) error: %s\n", entityName, err)
 
+    errMsg := wski18n.T(
+        "Unable to get action '{{.name}}': {{.err}}",
+        map[string]interface{}{
+            "name": entityName,
+            "err": err,
+        })
+
+    return nestedError(errMsg, err)
+}
+
+func actionCopyGetError(entityName string, err error) (error) {
+    whisk.Debug(whisk.DbgError, "client.Actions.Get(%s) error: %s\n", entityName, err)
+
     errMsg := wski18n.T(
         "Unable to obtain action '{{.name}}' to copy: {{.err}}",
         map[string]interface{}{
@@ -709,6 +1100,17 @@ func actionListError(entityName string, options *whisk.ActionListOptions, err er
     return nestedError(errMsg, err)
 }
 
+func nonNamespaceListArgError(arg string) (error) {
+    errMsg := wski18n.T(
+        "'{{.name}}' is not a valid argument for 'list'; 'list' accepts only a namespace, " +
+            "optionally qualified with a single package, e.g. 'list [/]NAMESPACE[/PACKAGE]'",
+        map[string]interface{}{
+            "name": arg,
+        })
+
+    return nonNestedError(errMsg)
+}
+
 func zipKindError(extension string) (error) {
     errMsg := wski18n.T("creating an action from a .zip artifact requires specifying the action kind explicitly")
 
@@ -731,6 +1133,58 @@ func javaEntryError() (error) {
     return nonNestedError(errMsg)
 }
 
+func actionSaveNoCodeError(entityName string) (error) {
+    errMsg := wski18n.T(
+        "Action '{{.name}}' has no code to save",
+        map[string]interface{}{
+            "name": entityName,
+        })
+
+    return nonNestedError(errMsg)
+}
+
+func actionSaveSequenceError() (error) {
+    errMsg := wski18n.T("A sequence action has no code of its own to save")
+
+    return nonNestedError(errMsg)
+}
+
+func actionSaveUnknownKindError(kind string) (error) {
+    errMsg := wski18n.T(
+        "Unable to determine a file extension for action kind '{{.kind}}'; use --save-as to choose a filename",
+        map[string]interface{}{
+            "kind": kind,
+        })
+
+    return nonNestedError(errMsg)
+}
+
+func actionSaveDecodeError(entityName string, err error) (error) {
+    whisk.Debug(whisk.DbgError, "base64.StdEncoding.DecodeString() error for action '%s': %s\n", entityName, err)
+
+    errMsg := wski18n.T(
+        "Unable to decode the code for action '{{.name}}': {{.err}}",
+        map[string]interface{}{
+            "name": entityName,
+            "err": err,
+        })
+
+    return nestedError(errMsg, err)
+}
+
+func actionSaveWriteError(filename string, err error) (error) {
+    whisk.Debug(whisk.DbgError, "ioutil.WriteFile(%s) error: %s\n", filename, err)
+
+    errMsg := wski18n.T(
+        "Unable to save action code to file '{{.name}}': {{.err}}",
+        map[string]interface{}{
+            "name": filename,
+            "err": err,
+        })
+
+    return nestedError(errMsg, err)
+}
+
 func printActionCreated(entityName string) {
     fmt.Fprintf(
         color.Output,
@@ -772,6 +1226,14 @@ func printInvocationMsg(
     activationID interface{},
     response map[string]interface{},
     outputStream io.Writer) {
+        if len(flags.common.format) > 0 {
+            if err := printFormatted(flags.common.format, response, outputStream); err != nil {
+                fmt.Fprintln(outputStream, err)
+            }
+
+            return
+        }
+
         if !flags.action.result {
             fmt.Fprintf(
                 outputStream,
@@ -816,6 +1278,17 @@ func printActionGet(entityName string, action *whisk.Action) {
     printJSON(action)
 }
 
+func printActionCodeSaved(entityName string, filename string) {
+    fmt.Fprintf(
+        color.Output,
+        wski18n.T(
+            "{{.ok}} saved action code to {{.name}}\n",
+            map[string]interface{}{
+                "ok": color.GreenString("ok:"),
+                "name": boldString(filename),
+            }))
+}
+
 func printActionDeleted(entityName string) {
     fmt.Fprintf(
         color.Output,
@@ -828,7 +1301,8 @@ func printActionDeleted(entityName string) {
 }
 
 func init() {
-    actionCreateCmd.Flags().BoolVar(&flags.action.docker, "docker", false, wski18n.T("treat ACTION as docker image path on dockerhub"))
+    actionCreateCmd.Flags().StringVar(&flags.action.docker, "docker", "", wski18n.T("use docker `IMAGE` to run the action; may be combined with a .zip ACTION payload"))
+    actionCreateCmd.Flags().Lookup("docker").NoOptDefVal = DOCKER_IMAGE_DEFAULT
     actionCreateCmd.Flags().BoolVar(&flags.action.copy, "copy", false, wski18n.T("treat ACTION as the name of an existing action"))
     actionCreateCmd.Flags().BoolVar(&flags.action.sequence, "sequence", false, wski18n.T("treat ACTION as comma separated sequence of actions to invoke"))
     actionCreateCmd.Flags().StringVar(&flags.action.kind, "kind", "", wski18n.T("the `KIND` of the action runtime (example: swift:3, nodejs:6)"))
@@ -846,7 +1320,8 @@ func init() {
     actionCreateCmd.Flags().BoolVar(&flags.action.raw, "raw", false, wski18n.T("treat ACTION as the name of an existing action"))
     actionCreateCmd.Flags().BoolVar(&flags.action.noRaw, "no-raw", false, wski18n.T("treat ACTION as the name of an existing action"))
 
-    actionUpdateCmd.Flags().BoolVar(&flags.action.docker, "docker", false, wski18n.T("treat ACTION as docker image path on dockerhub"))
+    actionUpdateCmd.Flags().StringVar(&flags.action.docker, "docker", "", wski18n.T("use docker `IMAGE` to run the action; may be combined with a .zip ACTION payload"))
+    actionUpdateCmd.Flags().Lookup("docker").NoOptDefVal = DOCKER_IMAGE_DEFAULT
     actionUpdateCmd.Flags().BoolVar(&flags.action.copy, "copy", false, wski18n.T("treat ACTION as the name of an existing action"))
     actionUpdateCmd.Flags().BoolVar(&flags.action.sequence, "sequence", false, wski18n.T("treat ACTION as comma separated sequence of actions to invoke"))
     actionUpdateCmd.Flags().StringVar(&flags.action.kind, "kind", "", wski18n.T("the `KIND` of the action runtime (example: swift:3, nodejs:6)"))
@@ -868,12 +1343,41 @@ func init() {
     actionInvokeCmd.Flags().StringVarP(&flags.common.paramFile, "param-file", "P", "", wski18n.T("`FILE` containing parameter values in JSON format"))
     actionInvokeCmd.Flags().BoolVarP(&flags.common.blocking, "blocking", "b", false, wski18n.T("blocking invoke"))
     actionInvokeCmd.Flags().BoolVarP(&flags.action.result, "result", "r", false, wski18n.T("show only activation result if a blocking activation (unless there is a failure)"))
+    actionInvokeCmd.Flags().BoolVarP(&flags.action.tail, "tail", "T", false, wski18n.T("stream the activation's logs as they are produced; requires --blocking"))
+    actionInvokeCmd.Flags().DurationVar(&flags.action.tailInterval, "tail-interval", time.Second, wski18n.T("how often to poll for new log lines while tailing, `DURATION`"))
+    actionInvokeCmd.Flags().DurationVar(&flags.action.wait, "wait", 60*time.Second, wski18n.T("total `DURATION` to keep polling for the result after a blocking invoke times out"))
+    actionInvokeCmd.Flags().DurationVar(&flags.action.waitInterval, "wait-interval", time.Second, wski18n.T("initial `DURATION` between polls after a blocking invoke times out; doubles up to a 5 second cap"))
+    actionInvokeCmd.Flags().IntVar(&flags.action.retryLimit, "retry-limit", 0, wski18n.T("maximum `NUMBER` of polls after a blocking invoke times out; 0 means no limit other than --wait"))
 
     actionGetCmd.Flags().BoolVarP(&flags.common.summary, "summary", "s", false, wski18n.T("summarize action details"))
+    actionGetCmd.Flags().BoolVar(&flags.action.save, "save", false, wski18n.T("save action code to file named after the action"))
+    actionGetCmd.Flags().StringVar(&flags.action.saveAs, "save-as", "", wski18n.T("save action code to file using a specific `FILENAME`"))
 
     actionListCmd.Flags().IntVarP(&flags.common.skip, "skip", "s", 0, wski18n.T("exclude the first `SKIP` number of actions from the result"))
     actionListCmd.Flags().IntVarP(&flags.common.limit, "limit", "l", 30, wski18n.T("only return `LIMIT` number of actions from the collection"))
 
+    actionCloneCmd.Flags().StringVar(&flags.action.kind, "kind", "", wski18n.T("the `KIND` of the action runtime (example: swift:3, nodejs:6)"))
+    actionCloneCmd.Flags().StringVar(&flags.action.main, "main", "", wski18n.T("the name of the action entry point (function or fully-qualified method name when applicable)"))
+    actionCloneCmd.Flags().StringVar(&flags.action.shared, "shared", "", wski18n.T("action visibility `SCOPE`; yes = shared, no = private"))
+    actionCloneCmd.Flags().StringVar(&flags.action.targetNamespace, "target-namespace", "", wski18n.T("clone the action into `NAMESPACE` instead of the source namespace"))
+    actionCloneCmd.Flags().IntVarP(&flags.action.timeout, "timeout", "t", TIMEOUT_LIMIT, wski18n.T("the timeout `LIMIT` in milliseconds after which the action is terminated"))
+    actionCloneCmd.Flags().IntVarP(&flags.action.memory, "memory", "m", MEMORY_LIMIT, wski18n.T("the maximum memory `LIMIT` in MB for the action"))
+    actionCloneCmd.Flags().IntVarP(&flags.action.logsize, "logsize", "l", LOGSIZE_LIMIT, wski18n.T("the maximum log size `LIMIT` in MB for the action"))
+    actionCloneCmd.Flags().StringSliceVarP(&flags.common.annotation, "annotation", "a", nil, wski18n.T("annotation values in `KEY VALUE` format"))
+    actionCloneCmd.Flags().StringVarP(&flags.common.annotFile, "annotation-file", "A", "", wski18n.T("`FILE` containing annotation values in JSON format"))
+    actionCloneCmd.Flags().StringSliceVarP(&flags.common.param, "param", "p", nil, wski18n.T("parameter values in `KEY VALUE` format"))
+    actionCloneCmd.Flags().StringVarP(&flags.common.paramFile, "param-file", "P", "", wski18n.T("`FILE` containing parameter values in JSON format"))
+    actionCloneCmd.Flags().BoolVar(&flags.action.web, "web", false, wski18n.T("make the cloned action a web action"))
+    actionCloneCmd.Flags().BoolVar(&flags.action.noWeb, "no-web", false, wski18n.T("make the cloned action a non-web action"))
+    actionCloneCmd.Flags().BoolVar(&flags.action.raw, "raw", false, wski18n.T("make the cloned action a raw HTTP web action"))
+    actionCloneCmd.Flags().BoolVar(&flags.action.noRaw, "no-raw", false, wski18n.T("make the cloned action neither a raw HTTP nor a web action"))
+
+    actionCmd.PersistentFlags().StringVar(
+        &flags.common.format,
+        "format",
+        "",
+        wski18n.T("render output using a Go `TEMPLATE`, or the 'json'/'jsonpath=EXPR' shortcuts"))
+
     actionCmd.AddCommand(
         actionCreateCmd,
         actionUpdateCmd,
@@ -881,5 +1385,6 @@ func init() {
         actionGetCmd,
         actionDeleteCmd,
         actionListCmd,
+        actionCloneCmd,
     )
 }
@@ -0,0 +1,147 @@
+/*
+ * Copyright 2015-2016 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package commands
+
+import (
+    "bytes"
+    "encoding/json"
+    "fmt"
+    "io"
+    "strings"
+    "text/template"
+    "time"
+
+    "../../go-whisk/whisk"
+    "../wski18n"
+
+    "github.com/fatih/color"
+)
+
+var formatTemplateFuncs = template.FuncMap{
+    "json": func(v interface{}) (string, error) {
+        b, err := json.Marshal(v)
+        return string(b), err
+    },
+    "jsonPretty": func(v interface{}) (string, error) {
+        b, err := json.MarshalIndent(v, "", "    ")
+        return string(b), err
+    },
+    "truncate": func(n int, s string) string {
+        runes := []rune(s)
+        if len(runes) <= n {
+            return s
+        }
+
+        return string(runes[:n])
+    },
+    "duration": func(ms int64) string {
+        return (time.Duration(ms) * time.Millisecond).String()
+    },
+}
+
+// "json" and "jsonpath=EXPR" are recognized as shortcuts; anything else is a text/template.
+func printFormatted(format string, data interface{}, out io.Writer) error {
+    switch {
+    case format == "json":
+        printJSON(data, out)
+        return nil
+    case strings.HasPrefix(format, "jsonpath="):
+        value, err := evalJSONPath(strings.TrimPrefix(format, "jsonpath="), data)
+
+        if err != nil {
+            return formatError(format, err)
+        }
+
+        fmt.Fprintln(out, value)
+
+        return nil
+    default:
+        tmpl, err := template.New("format").Funcs(formatTemplateFuncs).Parse(format)
+
+        if err != nil {
+            return formatError(format, err)
+        }
+
+        var rendered bytes.Buffer
+
+        if err = tmpl.Execute(&rendered, data); err != nil {
+            return formatError(format, err)
+        }
+
+        fmt.Fprintln(out, rendered.String())
+
+        return nil
+    }
+}
+
+// Only the dotted-field subset of jsonpath is supported -- no array indexing or wildcards.
+func evalJSONPath(expr string, data interface{}) (interface{}, error) {
+    expr = strings.TrimSpace(expr)
+    expr = strings.TrimPrefix(expr, "{")
+    expr = strings.TrimSuffix(expr, "}")
+    expr = strings.TrimPrefix(expr, ".")
+
+    raw, err := json.Marshal(data)
+    if err != nil {
+        return nil, err
+    }
+
+    var current interface{}
+    if err = json.Unmarshal(raw, &current); err != nil {
+        return nil, err
+    }
+
+    if len(expr) == 0 {
+        return current, nil
+    }
+
+    for _, key := range strings.Split(expr, ".") {
+        asMap, ok := current.(map[string]interface{})
+        if !ok {
+            return nil, fmt.Errorf("'%s' does not address a JSON object", key)
+        }
+
+        if current, ok = asMap[key]; !ok {
+            return nil, fmt.Errorf("no such field '%s'", key)
+        }
+    }
+
+    return current, nil
+}
+
+func printActionResult(action *whisk.Action, fallback func()) error {
+    if len(flags.common.format) > 0 {
+        return printFormatted(flags.common.format, action, color.Output)
+    }
+
+    fallback()
+
+    return nil
+}
+
+func formatError(format string, err error) (error) {
+    whisk.Debug(whisk.DbgError, "applying format '%s' failed: %s\n", format, err)
+
+    errMsg := wski18n.T(
+        "Unable to apply format '{{.format}}': {{.err}}",
+        map[string]interface{}{
+            "format": format,
+            "err": err,
+        })
+
+    return nestedError(errMsg, err)
+}
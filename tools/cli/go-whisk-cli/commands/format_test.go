@@ -0,0 +1,135 @@
+/*
+ * Copyright 2015-2016 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package commands
+
+import (
+    "bytes"
+    "reflect"
+    "strings"
+    "testing"
+)
+
+func TestTruncate(t *testing.T) {
+    truncate := formatTemplateFuncs["truncate"].(func(int, string) string)
+
+    tests := []struct {
+        name string
+        n    int
+        s    string
+        want string
+    }{
+        {"shorter than n", 10, "hello", "hello"},
+        {"exactly n", 5, "hello", "hello"},
+        {"longer than n", 3, "hello", "hel"},
+        {"multi-byte rune boundary", 2, "héllo", "hé"},
+    }
+
+    for _, test := range tests {
+        t.Run(test.name, func(t *testing.T) {
+            if got := truncate(test.n, test.s); got != test.want {
+                t.Errorf("truncate(%d, %q) = %q, want %q", test.n, test.s, got, test.want)
+            }
+        })
+    }
+}
+
+func TestDuration(t *testing.T) {
+    duration := formatTemplateFuncs["duration"].(func(int64) string)
+
+    if got, want := duration(1500), "1.5s"; got != want {
+        t.Errorf("duration(1500) = %q, want %q", got, want)
+    }
+}
+
+func TestEvalJSONPath(t *testing.T) {
+    data := map[string]interface{}{
+        "response": map[string]interface{}{
+            "result": map[string]interface{}{
+                "value": "ok",
+            },
+        },
+    }
+
+    tests := []struct {
+        name    string
+        expr    string
+        want    interface{}
+        wantErr bool
+    }{
+        {"dotted path", "response.result.value", "ok", false},
+        {"braced path", "{.response.result.value}", "ok", false},
+        {"empty path returns root", "", data, false},
+        {"missing field", "response.result.missing", nil, true},
+        {"path through a non-object", "response.result.value.nested", nil, true},
+    }
+
+    for _, test := range tests {
+        t.Run(test.name, func(t *testing.T) {
+            got, err := evalJSONPath(test.expr, data)
+
+            if test.wantErr {
+                if err == nil {
+                    t.Fatalf("evalJSONPath(%q): expected an error, got none", test.expr)
+                }
+
+                return
+            }
+
+            if err != nil {
+                t.Fatalf("evalJSONPath(%q): unexpected error: %s", test.expr, err)
+            }
+
+            if !reflect.DeepEqual(got, test.want) {
+                t.Errorf("evalJSONPath(%q) = %v, want %v", test.expr, got, test.want)
+            }
+        })
+    }
+}
+
+func TestPrintFormattedJSONPath(t *testing.T) {
+    data := map[string]interface{}{"name": "myaction"}
+    var out bytes.Buffer
+
+    if err := printFormatted("jsonpath=name", data, &out); err != nil {
+        t.Fatalf("printFormatted: unexpected error: %s", err)
+    }
+
+    if got, want := strings.TrimSpace(out.String()), "myaction"; got != want {
+        t.Errorf("printFormatted output = %q, want %q", got, want)
+    }
+}
+
+func TestPrintFormattedTemplate(t *testing.T) {
+    data := map[string]interface{}{"name": "myaction"}
+    var out bytes.Buffer
+
+    if err := printFormatted("{{.name}}", data, &out); err != nil {
+        t.Fatalf("printFormatted: unexpected error: %s", err)
+    }
+
+    if got, want := strings.TrimSpace(out.String()), "myaction"; got != want {
+        t.Errorf("printFormatted output = %q, want %q", got, want)
+    }
+}
+
+func TestPrintFormattedInvalidTemplate(t *testing.T) {
+    var out bytes.Buffer
+
+    if err := printFormatted("{{.name", map[string]interface{}{}, &out); err == nil {
+        t.Fatal("printFormatted: expected an error for an unclosed template action, got none")
+    }
+}
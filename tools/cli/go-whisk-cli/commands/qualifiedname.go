@@ -0,0 +1,79 @@
+/*
+ * Copyright 2015-2016 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package commands
+
+import (
+    "errors"
+    "strings"
+)
+
+const PATH_SEPARATOR = "/"
+
+// The entity name may itself be package-qualified (package/action).
+type QualifiedName struct {
+    namespace  string
+    entityName string
+}
+
+func parseQualifiedName(name string) (QualifiedName, error) {
+    qualifiedName := QualifiedName{}
+
+    name = strings.TrimPrefix(name, PATH_SEPARATOR)
+
+    if len(name) == 0 {
+        return qualifiedName, errors.New("An entity name is required.")
+    }
+
+    parts := strings.Split(name, PATH_SEPARATOR)
+
+    switch len(parts) {
+    case 1:
+        qualifiedName.namespace = getClientNamespace()
+        qualifiedName.entityName = parts[0]
+    case 2:
+        qualifiedName.namespace = parts[0]
+        qualifiedName.entityName = parts[1]
+    case 3:
+        qualifiedName.namespace = parts[0]
+        qualifiedName.entityName = parts[1] + PATH_SEPARATOR + parts[2]
+    default:
+        return qualifiedName, errors.New(
+            "A valid qualified name has the form '[/NAMESPACE/[PACKAGE/]]ENTITY'; " +
+                "'" + name + "' has too many path segments.")
+    }
+
+    if len(qualifiedName.namespace) == 0 {
+        return qualifiedName, errors.New("A namespace is required.")
+    }
+
+    if len(qualifiedName.entityName) == 0 {
+        return qualifiedName, errors.New("An entity name is required.")
+    }
+
+    return qualifiedName, nil
+}
+
+// Only catches a 3-segment arg (NAMESPACE/PACKAGE/ENTITY); a 2-segment arg is always
+// treated as NAMESPACE/PACKAGE, since nothing short of a server round-trip can tell that
+// apart from NAMESPACE/ENTITY -- the "list" Use string calls this out.
+func validateNamespaceListArg(arg string, qualifiedName QualifiedName) error {
+    if strings.Contains(qualifiedName.entityName, PATH_SEPARATOR) {
+        return nonNamespaceListArgError(arg)
+    }
+
+    return nil
+}
@@ -0,0 +1,98 @@
+/*
+ * Copyright 2015-2016 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package commands
+
+import (
+    "testing"
+)
+
+func TestParseQualifiedName(t *testing.T) {
+    tests := []struct {
+        name           string
+        arg            string
+        wantNamespace  string
+        wantEntityName string
+        wantErr        bool
+    }{
+        {"namespace/name", "myns/myaction", "myns", "myaction", false},
+        {"leading slash", "/myns/myaction", "myns", "myaction", false},
+        {"namespace/package/name", "myns/mypkg/myaction", "myns", "mypkg/myaction", false},
+        {"leading slash, three segments", "/myns/mypkg/myaction", "myns", "mypkg/myaction", false},
+        {"too many segments", "myns/mypkg/mysubpkg/myaction", "", "", true},
+        {"empty", "", "", "", true},
+        {"namespace with no entity name", "myns/", "", "", true},
+    }
+
+    for _, test := range tests {
+        t.Run(test.name, func(t *testing.T) {
+            qualifiedName, err := parseQualifiedName(test.arg)
+
+            if test.wantErr {
+                if err == nil {
+                    t.Fatalf("parseQualifiedName(%q): expected an error, got none", test.arg)
+                }
+
+                return
+            }
+
+            if err != nil {
+                t.Fatalf("parseQualifiedName(%q): unexpected error: %s", test.arg, err)
+            }
+
+            if qualifiedName.namespace != test.wantNamespace {
+                t.Errorf("parseQualifiedName(%q).namespace = %q, want %q",
+                    test.arg, qualifiedName.namespace, test.wantNamespace)
+            }
+
+            if qualifiedName.entityName != test.wantEntityName {
+                t.Errorf("parseQualifiedName(%q).entityName = %q, want %q",
+                    test.arg, qualifiedName.entityName, test.wantEntityName)
+            }
+        })
+    }
+}
+
+func TestValidateNamespaceListArg(t *testing.T) {
+    tests := []struct {
+        name    string
+        arg     string
+        wantErr bool
+    }{
+        {"bare namespace", "myns", false},
+        {"namespace/package", "myns/mypkg", false},
+        {"namespace/package/action", "myns/mypkg/myaction", true},
+    }
+
+    for _, test := range tests {
+        t.Run(test.name, func(t *testing.T) {
+            qualifiedName, err := parseQualifiedName(test.arg)
+            if err != nil {
+                t.Fatalf("parseQualifiedName(%q): unexpected error: %s", test.arg, err)
+            }
+
+            err = validateNamespaceListArg(test.arg, qualifiedName)
+
+            if test.wantErr && err == nil {
+                t.Errorf("validateNamespaceListArg(%q): expected an error, got none", test.arg)
+            }
+
+            if !test.wantErr && err != nil {
+                t.Errorf("validateNamespaceListArg(%q): unexpected error: %s", test.arg, err)
+            }
+        })
+    }
+}
@@ -0,0 +1,163 @@
+/*
+ * Copyright 2015-2016 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package whisk
+
+import (
+    "fmt"
+    "net/http"
+)
+
+type ActionService struct {
+    client *Client
+}
+
+type Action struct {
+    Namespace   string      `json:"namespace,omitempty"`
+    Name        string      `json:"name,omitempty"`
+    Version     string      `json:"version,omitempty"`
+    Exec        *Exec       `json:"exec,omitempty"`
+    Annotations KeyValueArr `json:"annotations,omitempty"`
+    Parameters  KeyValueArr `json:"parameters,omitempty"`
+    Limits      *Limits     `json:"limits,omitempty"`
+    Publish     *bool       `json:"publish,omitempty"`
+}
+
+type Exec struct {
+    Kind       string   `json:"kind,omitempty"`
+    Code       *string  `json:"code,omitempty"`
+    Image      string   `json:"image,omitempty"`
+    Init       string   `json:"init,omitempty"`
+    Main       string   `json:"main,omitempty"`
+    Components []string `json:"components,omitempty"`
+    Jar        string   `json:"jar,omitempty"`
+}
+
+type Limits struct {
+    Timeout *int `json:"timeout,omitempty"`
+    Memory  *int `json:"memory,omitempty"`
+    Logsize *int `json:"logs,omitempty"`
+}
+
+type ActionListOptions struct {
+    Limit int `url:"limit"`
+    Skip  int `url:"skip"`
+    Docs  bool `url:"docs,omitempty"`
+}
+
+// The zero value (Code: false, omitted from the URL) lets the controller apply its own
+// default rather than this client claiming one.
+type ActionGetOptions struct {
+    Code bool `url:"code,omitempty"`
+}
+
+func (s *ActionService) List(packageName string, options *ActionListOptions) ([]Action, *http.Response, error) {
+    route := fmt.Sprintf("actions/%s", packageName)
+
+    route, err := addRouteOptions(route, options)
+    if err != nil {
+        return nil, nil, fmt.Errorf("Unable to add route options '%#v'", options)
+    }
+
+    req, err := s.client.NewRequest("GET", route, nil, ExcludeNamespaceInUrl)
+    if err != nil {
+        return nil, nil, err
+    }
+
+    var actions []Action
+    resp, err := s.client.Do(req, &actions, ExcludeNamespaceInUrl)
+    if err != nil {
+        return nil, resp, err
+    }
+
+    return actions, resp, nil
+}
+
+func (s *ActionService) Insert(action *Action, overwrite bool) (*Action, *http.Response, error) {
+    route := fmt.Sprintf("actions/%s?overwrite=%t", action.Name, overwrite)
+
+    req, err := s.client.NewRequest("PUT", route, action, IncludeNamespaceInUrl)
+    if err != nil {
+        return nil, nil, err
+    }
+
+    a := new(Action)
+    resp, err := s.client.Do(req, a, IncludeNamespaceInUrl)
+    if err != nil {
+        return nil, resp, err
+    }
+
+    return a, resp, nil
+}
+
+func (s *ActionService) Get(actionName string, options ...*ActionGetOptions) (*Action, *http.Response, error) {
+    route := fmt.Sprintf("actions/%s", actionName)
+
+    if len(options) > 0 {
+        var err error
+        if route, err = addRouteOptions(route, options[0]); err != nil {
+            return nil, nil, fmt.Errorf("Unable to add route options '%#v'", options[0])
+        }
+    }
+
+    req, err := s.client.NewRequest("GET", route, nil, IncludeNamespaceInUrl)
+    if err != nil {
+        return nil, nil, err
+    }
+
+    a := new(Action)
+    resp, err := s.client.Do(req, a, IncludeNamespaceInUrl)
+    if err != nil {
+        return nil, resp, err
+    }
+
+    return a, resp, nil
+}
+
+func (s *ActionService) Delete(actionName string) (*Action, *http.Response, error) {
+    route := fmt.Sprintf("actions/%s", actionName)
+
+    req, err := s.client.NewRequest("DELETE", route, nil, IncludeNamespaceInUrl)
+    if err != nil {
+        return nil, nil, err
+    }
+
+    a := new(Action)
+    resp, err := s.client.Do(req, a, IncludeNamespaceInUrl)
+    if err != nil {
+        return nil, resp, err
+    }
+
+    return a, resp, nil
+}
+
+func (s *ActionService) Invoke(actionName string, payload interface{}, blocking bool, result bool) (map[string]interface{}, *http.Response, error) {
+    route := fmt.Sprintf("actions/%s?blocking=%t&result=%t", actionName, blocking, result)
+
+    req, err := s.client.NewRequest("POST", route, payload, IncludeNamespaceInUrl)
+    if err != nil {
+        return nil, nil, err
+    }
+
+    var res map[string]interface{}
+    resp, err := s.client.Do(req, &res, IncludeNamespaceInUrl)
+    if err != nil {
+        // Callers read the activation id and result from res on timeouts and application errors.
+        return res, resp, err
+    }
+
+    return res, resp, nil
+}
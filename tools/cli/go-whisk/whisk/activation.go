@@ -0,0 +1,78 @@
+/*
+ * Copyright 2015-2016 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package whisk
+
+import (
+    "fmt"
+    "net/http"
+)
+
+type ActivationService struct {
+    client *Client
+}
+
+type Activation struct {
+    Namespace    string                 `json:"namespace,omitempty"`
+    Name         string                 `json:"name,omitempty"`
+    Version      string                 `json:"version,omitempty"`
+    ActivationID string                 `json:"activationId,omitempty"`
+    Start        int64                  `json:"start,omitempty"`
+    End          int64                  `json:"end,omitempty"`
+    Duration     int64                  `json:"duration,omitempty"`
+    Response     map[string]interface{} `json:"response,omitempty"`
+    Logs         []string               `json:"logs,omitempty"`
+    Annotations  KeyValueArr            `json:"annotations,omitempty"`
+}
+
+// ActivationLogs is the body of GET /activations/{id}/logs.
+type ActivationLogs struct {
+    Logs []string `json:"logs,omitempty"`
+}
+
+func (s *ActivationService) Get(activationID string) (*Activation, *http.Response, error) {
+    route := fmt.Sprintf("activations/%s", activationID)
+
+    req, err := s.client.NewRequest("GET", route, nil, IncludeNamespaceInUrl)
+    if err != nil {
+        return nil, nil, err
+    }
+
+    a := new(Activation)
+    resp, err := s.client.Do(req, a, IncludeNamespaceInUrl)
+    if err != nil {
+        return nil, resp, err
+    }
+
+    return a, resp, nil
+}
+
+func (s *ActivationService) Logs(activationID string) (*ActivationLogs, *http.Response, error) {
+    route := fmt.Sprintf("activations/%s/logs", activationID)
+
+    req, err := s.client.NewRequest("GET", route, nil, IncludeNamespaceInUrl)
+    if err != nil {
+        return nil, nil, err
+    }
+
+    logs := new(ActivationLogs)
+    resp, err := s.client.Do(req, logs, IncludeNamespaceInUrl)
+    if err != nil {
+        return nil, resp, err
+    }
+
+    return logs, resp, nil
+}